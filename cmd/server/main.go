@@ -0,0 +1,88 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/handlers"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/repository"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/scheduler"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/service"
+)
+
+// jwtSigningKey returns the secret used to sign and verify auth tokens,
+// configurable via the JWT_SECRET environment variable. In gin's release
+// mode we refuse to start without one, since the fallback dev secret is
+// public and would let anyone mint a valid (including admin) token.
+func jwtSigningKey() []byte {
+	secret := os.Getenv("JWT_SECRET")
+	if secret != "" {
+		return []byte(secret)
+	}
+	if gin.Mode() == gin.ReleaseMode {
+		log.Fatal("JWT_SECRET is not set; refusing to start in release mode with the public dev secret")
+	}
+	log.Println("WARNING: JWT_SECRET is not set, falling back to the public dev-secret-change-me signing key")
+	return []byte("dev-secret-change-me")
+}
+
+func main() {
+	db, err := sql.Open("sqlite3", "./task.db")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer db.Close()
+
+	if err := repository.Migrate(db); err != nil {
+		log.Fatal(err)
+	}
+
+	taskRepo := repository.NewSQLiteTaskRepository(db)
+	userRepo := repository.NewSQLiteUserRepository(db)
+
+	taskService := service.NewTaskService(taskRepo)
+	authService := service.NewAuthService(userRepo, jwtSigningKey())
+
+	taskHandler := handlers.NewTaskHandler(taskService)
+	authHandler := handlers.NewAuthHandler(authService)
+
+	sched := scheduler.New(taskRepo, scheduler.NewNotifierFromEnv())
+	sched.Start()
+
+	router := gin.Default()
+
+	router.GET("/", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "Hello, This is Task Management Api"})
+	})
+
+	router.POST("/auth/register", authHandler.Register)
+	router.POST("/auth/login", authHandler.Login)
+
+	authorized := router.Group("/MANAGEMENT")
+	authorized.Use(handlers.AuthMiddleware(authService))
+	authorized.POST("", taskHandler.Create)
+	authorized.GET("/:id", taskHandler.Retrieve)
+	authorized.PUT("/:id", taskHandler.Update)
+	authorized.PATCH("/:id", taskHandler.Patch)
+	authorized.DELETE("/:id", taskHandler.Delete)
+	authorized.GET("", taskHandler.List)
+	authorized.POST("/:id/snooze", taskHandler.Snooze)
+	authorized.POST("/:id/cancel", taskHandler.Cancel)
+	authorized.POST("/bulk", taskHandler.BulkCreate)
+	authorized.DELETE("/bulk", taskHandler.BulkDelete)
+	authorized.PATCH("/bulk", taskHandler.BulkPatch)
+	authorized.GET("/export", taskHandler.Export)
+	authorized.POST("/import", taskHandler.Import)
+
+	port := 8080
+	log.Printf("Server is running on port %d\n", port)
+	if err := router.Run(fmt.Sprintf(":%d", port)); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/service"
+)
+
+// loggedUserKey is the gin context key the authenticated user is stashed
+// under by AuthMiddleware.
+const loggedUserKey = "LoggedUser"
+
+// AuthMiddleware extracts the bearer token from the Authorization header,
+// verifies it via authService, and stores the authenticated user on the
+// context so downstream handlers can scope queries to its owner.
+func AuthMiddleware(authService *service.AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		if !strings.HasPrefix(header, "Bearer ") {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Missing or invalid Authorization header"})
+			c.Abort()
+			return
+		}
+		tokenString := strings.TrimPrefix(header, "Bearer ")
+
+		claims, err := authService.ParseToken(tokenString)
+		if err != nil {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
+			c.Abort()
+			return
+		}
+
+		c.Set(loggedUserKey, models.User{ID: claims.UserID, IsAdmin: claims.IsAdmin})
+		c.Next()
+	}
+}
+
+// loggedUser reads the authenticated user stashed on the context by
+// AuthMiddleware.
+func loggedUser(c *gin.Context) models.User {
+	u, _ := c.Get(loggedUserKey)
+	return u.(models.User)
+}
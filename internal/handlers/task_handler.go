@@ -0,0 +1,350 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/repository"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/service"
+)
+
+type bulkIDsRequest struct {
+	IDs []int `json:"ids"`
+}
+
+type bulkPatchRequest struct {
+	IDs    []int                  `json:"ids"`
+	Fields map[string]interface{} `json:"fields"`
+}
+
+// TaskHandler exposes the task CRUD and lifecycle endpoints over HTTP. It
+// depends only on service.TaskService, so it can be unit-tested with a mock
+// repository underneath.
+type TaskHandler struct {
+	service *service.TaskService
+}
+
+// NewTaskHandler builds a TaskHandler over the given service.
+func NewTaskHandler(svc *service.TaskService) *TaskHandler {
+	return &TaskHandler{service: svc}
+}
+
+// writeServiceError maps a service-layer error to the matching HTTP status.
+func writeServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Task not found"})
+	case errors.Is(err, service.ErrInvalidTransition):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	case errors.Is(err, service.ErrValidation):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+	}
+}
+
+func (h *TaskHandler) Create(c *gin.Context) {
+	var task models.Task
+	if err := c.ShouldBindJSON(&task); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request payload"})
+		return
+	}
+	task.UserID = loggedUser(c).ID
+
+	created, err := h.service.Create(task)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, created)
+}
+
+func (h *TaskHandler) Retrieve(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task id"})
+		return
+	}
+	user := loggedUser(c)
+
+	task, err := h.service.Get(id, user.ID, user.IsAdmin)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, task)
+}
+
+func (h *TaskHandler) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task id"})
+		return
+	}
+	user := loggedUser(c)
+
+	var updated models.Task
+	if err := c.ShouldBindJSON(&updated); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request payload"})
+		return
+	}
+
+	result, err := h.service.Replace(id, user.ID, user.IsAdmin, updated)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, result)
+}
+
+func (h *TaskHandler) Patch(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task id"})
+		return
+	}
+	user := loggedUser(c)
+
+	var fields map[string]interface{}
+	if err := c.ShouldBindJSON(&fields); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request payload"})
+		return
+	}
+
+	result, err := h.service.Patch(id, user.ID, user.IsAdmin, fields)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, result)
+}
+
+func (h *TaskHandler) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task id"})
+		return
+	}
+	user := loggedUser(c)
+
+	if err := h.service.Delete(id, user.ID, user.IsAdmin); err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task deleted successfully"})
+}
+
+func (h *TaskHandler) List(c *gin.Context) {
+	user := loggedUser(c)
+	filter := repository.TaskFilter{
+		UserID:    user.ID,
+		IsAdmin:   user.IsAdmin,
+		Status:    c.Query("status"),
+		DueBefore: c.Query("due_before"),
+		DueAfter:  c.Query("due_after"),
+		Q:         c.Query("q"),
+		SortBy:    c.DefaultQuery("sort_by", "id"),
+		SortOrder: strings.ToUpper(c.DefaultQuery("sort_order", "ASC")),
+	}
+
+	if v := c.Query("page"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "page must be a positive integer"})
+			return
+		}
+		filter.Page = n
+	}
+	if v := c.Query("page_size"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "page_size must be a positive integer"})
+			return
+		}
+		filter.PageSize = n
+	}
+	if filter.SortOrder != "ASC" && filter.SortOrder != "DESC" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "sort_order must be asc or desc"})
+		return
+	}
+
+	tasks, total, err := h.service.List(&filter)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	totalPages := (total + filter.PageSize - 1) / filter.PageSize
+	c.JSON(http.StatusOK, gin.H{
+		"data":        tasks,
+		"page":        filter.Page,
+		"page_size":   filter.PageSize,
+		"total":       total,
+		"total_pages": totalPages,
+	})
+}
+
+func (h *TaskHandler) Snooze(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task id"})
+		return
+	}
+	user := loggedUser(c)
+
+	var req struct {
+		Duration string `json:"duration"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil || req.Duration == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "duration is required"})
+		return
+	}
+	offset, err := time.ParseDuration(req.Duration)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "duration must be a valid Go duration (e.g. \"1h\", \"30m\")"})
+		return
+	}
+
+	result, err := h.service.Snooze(id, user.ID, user.IsAdmin, offset)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, result)
+}
+
+func (h *TaskHandler) Cancel(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid task id"})
+		return
+	}
+	user := loggedUser(c)
+
+	if err := h.service.Cancel(id, user.ID, user.IsAdmin); err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"message": "Task reminders cancelled"})
+}
+
+// BulkCreate inserts a JSON array of tasks in a single transaction, rolled
+// back if any row fails validation, returning per-row success/error results.
+func (h *TaskHandler) BulkCreate(c *gin.Context) {
+	var tasks []models.Task
+	if err := c.ShouldBindJSON(&tasks); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request payload"})
+		return
+	}
+
+	user := loggedUser(c)
+	results, err := h.service.BulkCreate(tasks, user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"results": results, "error": "Failed to create tasks"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"results": results})
+}
+
+func (h *TaskHandler) BulkDelete(c *gin.Context) {
+	var req bulkIDsRequest
+	if err := c.ShouldBindJSON(&req); err != nil || len(req.IDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ids is required"})
+		return
+	}
+
+	user := loggedUser(c)
+	deleted, err := h.service.BulkDelete(req.IDs, user.ID, user.IsAdmin)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to delete tasks"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deleted": deleted})
+}
+
+func (h *TaskHandler) BulkPatch(c *gin.Context) {
+	var req bulkPatchRequest
+	if err := c.ShouldBindJSON(&req); err != nil || len(req.IDs) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "ids is required"})
+		return
+	}
+
+	user := loggedUser(c)
+	updated, err := h.service.BulkPatch(req.IDs, user.ID, user.IsAdmin, req.Fields)
+	if err != nil {
+		writeServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"updated": updated})
+}
+
+// Export streams the caller's tasks as CSV or an iCalendar VTODO feed,
+// selected via ?format=csv|ics (defaults to csv).
+func (h *TaskHandler) Export(c *gin.Context) {
+	user := loggedUser(c)
+	tasks, err := h.service.Export(user.ID, user.IsAdmin)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to export tasks"})
+		return
+	}
+
+	format := c.DefaultQuery("format", "csv")
+	switch format {
+	case "csv":
+		c.Header("Content-Disposition", `attachment; filename="tasks.csv"`)
+		c.Header("Content-Type", "text/csv")
+		if err := service.WriteCSV(c.Writer, tasks); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to write CSV"})
+		}
+	case "ics":
+		c.Header("Content-Disposition", `attachment; filename="tasks.ics"`)
+		c.Header("Content-Type", "text/calendar")
+		if err := service.WriteICS(c.Writer, tasks); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to write ICS"})
+		}
+	default:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be csv or ics"})
+	}
+}
+
+// Import creates tasks from an uploaded multipart CSV or ICS file, reporting
+// how many rows were created, skipped, or failed.
+func (h *TaskHandler) Import(c *gin.Context) {
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	defer file.Close()
+
+	var tasks []models.Task
+	if strings.HasSuffix(strings.ToLower(header.Filename), ".ics") {
+		tasks, err = service.ParseICS(file)
+	} else {
+		tasks, err = service.ParseCSV(file)
+	}
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Failed to parse file"})
+		return
+	}
+
+	user := loggedUser(c)
+	result := h.service.Import(tasks, user.ID)
+	c.JSON(http.StatusCreated, result)
+}
@@ -0,0 +1,507 @@
+package handlers
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/repository"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/service"
+)
+
+// fakeTaskRepo is an in-memory stand-in for repository.TaskRepository so
+// handler tests don't need a real database.
+type fakeTaskRepo struct {
+	tasks  map[int]models.Task
+	nextID int
+}
+
+func newFakeTaskRepo() *fakeTaskRepo {
+	return &fakeTaskRepo{tasks: map[int]models.Task{}, nextID: 1}
+}
+
+func (r *fakeTaskRepo) Create(task *models.Task) error {
+	task.ID = r.nextID
+	r.nextID++
+	r.tasks[task.ID] = *task
+	return nil
+}
+
+func (r *fakeTaskRepo) owned(task models.Task, userID int, isAdmin bool) bool {
+	return isAdmin || task.UserID == userID
+}
+
+func (r *fakeTaskRepo) GetByID(id int, userID int, isAdmin bool) (*models.Task, error) {
+	task, ok := r.tasks[id]
+	if !ok || !r.owned(task, userID, isAdmin) {
+		return nil, sql.ErrNoRows
+	}
+	return &task, nil
+}
+
+func (r *fakeTaskRepo) Update(task *models.Task) error {
+	existing, ok := r.tasks[task.ID]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	task.UserID = existing.UserID
+	r.tasks[task.ID] = *task
+	return nil
+}
+
+func (r *fakeTaskRepo) PatchFields(id int, userID int, isAdmin bool, fields map[string]interface{}) error {
+	task, ok := r.tasks[id]
+	if !ok || !r.owned(task, userID, isAdmin) {
+		return sql.ErrNoRows
+	}
+	for field, value := range fields {
+		str, _ := value.(string)
+		switch field {
+		case "title":
+			task.Title = str
+		case "description":
+			task.Description = str
+		case "due_date":
+			task.DueDate = str
+		case "status":
+			task.Status = str
+		}
+	}
+	r.tasks[id] = task
+	return nil
+}
+
+func (r *fakeTaskRepo) Delete(id int, userID int, isAdmin bool) (bool, error) {
+	task, ok := r.tasks[id]
+	if !ok || !r.owned(task, userID, isAdmin) {
+		return false, nil
+	}
+	delete(r.tasks, id)
+	return true, nil
+}
+
+func (r *fakeTaskRepo) List(filter repository.TaskFilter) ([]models.Task, int, error) {
+	var matched []models.Task
+	for _, task := range r.tasks {
+		if filter.IsAdmin || task.UserID == filter.UserID {
+			matched = append(matched, task)
+		}
+	}
+	return matched, len(matched), nil
+}
+
+func (r *fakeTaskRepo) DueForReminder(cutoff string) ([]models.Task, error) { return nil, nil }
+func (r *fakeTaskRepo) MarkNotified(id int, notifiedAt string) error       { return nil }
+
+func (r *fakeTaskRepo) Snooze(id int, userID int, isAdmin bool, newDueDate string) (*models.Task, error) {
+	task, ok := r.tasks[id]
+	if !ok || !r.owned(task, userID, isAdmin) {
+		return nil, sql.ErrNoRows
+	}
+	task.DueDate = newDueDate
+	r.tasks[id] = task
+	return &task, nil
+}
+
+func (r *fakeTaskRepo) Cancel(id int, userID int, isAdmin bool, notifiedAt string) (bool, error) {
+	task, ok := r.tasks[id]
+	if !ok || !r.owned(task, userID, isAdmin) {
+		return false, nil
+	}
+	task.Recurrence = models.RecurrenceNone
+	r.tasks[id] = task
+	return true, nil
+}
+
+func (r *fakeTaskRepo) BulkCreate(tasks []models.Task) error {
+	for i := range tasks {
+		if err := r.Create(&tasks[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeTaskRepo) BulkDelete(ids []int, userID int, isAdmin bool) (int64, error) {
+	var count int64
+	for _, id := range ids {
+		if deleted, _ := r.Delete(id, userID, isAdmin); deleted {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeTaskRepo) BulkPatch(ids []int, userID int, isAdmin bool, fields map[string]interface{}) (int64, error) {
+	var count int64
+	for _, id := range ids {
+		if err := r.PatchFields(id, userID, isAdmin, fields); err == nil {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (r *fakeTaskRepo) ListAll(userID int, isAdmin bool) ([]models.Task, error) {
+	tasks, _, err := r.List(repository.TaskFilter{UserID: userID, IsAdmin: isAdmin})
+	return tasks, err
+}
+
+// withUser injects a fixed authenticated user into the gin context, standing
+// in for handlers.AuthMiddleware in these handler-only tests.
+func withUser(user models.User) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(loggedUserKey, user)
+		c.Next()
+	}
+}
+
+func newTestRouter(repo repository.TaskRepository, user models.User) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	handler := NewTaskHandler(service.NewTaskService(repo))
+
+	router := gin.New()
+	router.Use(withUser(user))
+	router.POST("/MANAGEMENT", handler.Create)
+	router.GET("/MANAGEMENT/:id", handler.Retrieve)
+	router.PUT("/MANAGEMENT/:id", handler.Update)
+	router.PATCH("/MANAGEMENT/:id", handler.Patch)
+	router.DELETE("/MANAGEMENT/:id", handler.Delete)
+	router.GET("/MANAGEMENT", handler.List)
+	router.POST("/MANAGEMENT/:id/snooze", handler.Snooze)
+	router.POST("/MANAGEMENT/:id/cancel", handler.Cancel)
+	router.POST("/MANAGEMENT/bulk", handler.BulkCreate)
+	router.DELETE("/MANAGEMENT/bulk", handler.BulkDelete)
+	router.PATCH("/MANAGEMENT/bulk", handler.BulkPatch)
+	router.GET("/MANAGEMENT/export", handler.Export)
+	router.POST("/MANAGEMENT/import", handler.Import)
+	return router
+}
+
+func doRequest(router *gin.Engine, method, path string, body interface{}) *httptest.ResponseRecorder {
+	var reader *bytes.Reader
+	if body != nil {
+		b, _ := json.Marshal(body)
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestTaskHandler_Create(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "valid task",
+			body:       map[string]interface{}{"title": "Write report", "due_date": "2026-01-01", "status": "pending"},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "invalid due_date",
+			body:       map[string]interface{}{"title": "Write report", "due_date": "not-a-date", "status": "pending"},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(newFakeTaskRepo(), models.User{ID: 1})
+			rec := doRequest(router, http.MethodPost, "/MANAGEMENT", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body=%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestTaskHandler_RetrieveOwnership(t *testing.T) {
+	repo := newFakeTaskRepo()
+	repo.tasks[1] = models.Task{ID: 1, Title: "Alice's task", UserID: 1, Status: "pending"}
+
+	tests := []struct {
+		name       string
+		user       models.User
+		wantStatus int
+	}{
+		{name: "owner can retrieve", user: models.User{ID: 1}, wantStatus: http.StatusOK},
+		{name: "other user gets 404", user: models.User{ID: 2}, wantStatus: http.StatusNotFound},
+		{name: "admin can retrieve", user: models.User{ID: 2, IsAdmin: true}, wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(repo, tt.user)
+			rec := doRequest(router, http.MethodGet, "/MANAGEMENT/1", nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body=%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestTaskHandler_PatchStatusTransition(t *testing.T) {
+	tests := []struct {
+		name       string
+		from       string
+		to         string
+		wantStatus int
+	}{
+		{name: "pending to in_progress allowed", from: "pending", to: "in_progress", wantStatus: http.StatusAccepted},
+		{name: "pending to done rejected", from: "pending", to: "done", wantStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeTaskRepo()
+			repo.tasks[1] = models.Task{ID: 1, Title: "Task", UserID: 1, Status: tt.from, DueDate: "2026-01-01"}
+
+			router := newTestRouter(repo, models.User{ID: 1})
+			rec := doRequest(router, http.MethodPatch, "/MANAGEMENT/1", map[string]interface{}{"status": tt.to})
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body=%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestTaskHandler_Delete(t *testing.T) {
+	repo := newFakeTaskRepo()
+	repo.tasks[1] = models.Task{ID: 1, UserID: 1, Status: "pending"}
+
+	router := newTestRouter(repo, models.User{ID: 1})
+
+	rec := doRequest(router, http.MethodDelete, "/MANAGEMENT/1", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	rec = doRequest(router, http.MethodDelete, "/MANAGEMENT/1", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("second delete status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestTaskHandler_List(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantStatus int
+	}{
+		{name: "defaults, no query params", query: "", wantStatus: http.StatusOK},
+		{name: "explicit page and page_size", query: "?page=1&page_size=5", wantStatus: http.StatusOK},
+		{name: "invalid page_size", query: "?page_size=0", wantStatus: http.StatusBadRequest},
+		{name: "invalid sort_order", query: "?sort_order=sideways", wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeTaskRepo()
+			repo.tasks[1] = models.Task{ID: 1, Title: "Task", UserID: 1, Status: "pending", DueDate: "2026-01-01"}
+
+			router := newTestRouter(repo, models.User{ID: 1})
+			rec := doRequest(router, http.MethodGet, "/MANAGEMENT"+tt.query, nil)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body=%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestTaskHandler_Update(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "valid transition",
+			body:       map[string]interface{}{"title": "Task", "due_date": "2026-01-01", "status": "in_progress"},
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "invalid transition",
+			body:       map[string]interface{}{"title": "Task", "due_date": "2026-01-01", "status": "done"},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newFakeTaskRepo()
+			repo.tasks[1] = models.Task{ID: 1, Title: "Task", UserID: 1, Status: "pending", DueDate: "2026-01-01"}
+
+			router := newTestRouter(repo, models.User{ID: 1})
+			rec := doRequest(router, http.MethodPut, "/MANAGEMENT/1", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body=%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestTaskHandler_Snooze(t *testing.T) {
+	repo := newFakeTaskRepo()
+	repo.tasks[1] = models.Task{ID: 1, Title: "Task", UserID: 1, Status: "pending", DueDate: "2026-01-01"}
+
+	router := newTestRouter(repo, models.User{ID: 1})
+
+	rec := doRequest(router, http.MethodPost, "/MANAGEMENT/1/snooze", map[string]interface{}{"duration": "24h"})
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("status = %d, want %d (body=%s)", rec.Code, http.StatusAccepted, rec.Body.String())
+	}
+
+	rec = doRequest(router, http.MethodPost, "/MANAGEMENT/1/snooze", map[string]interface{}{"duration": "not-a-duration"})
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("invalid duration status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestTaskHandler_Cancel(t *testing.T) {
+	repo := newFakeTaskRepo()
+	repo.tasks[1] = models.Task{ID: 1, Title: "Task", UserID: 1, Status: "pending", DueDate: "2026-01-01", Recurrence: models.RecurrenceDaily}
+
+	router := newTestRouter(repo, models.User{ID: 1})
+
+	rec := doRequest(router, http.MethodPost, "/MANAGEMENT/1/cancel", nil)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("status = %d, want %d (body=%s)", rec.Code, http.StatusAccepted, rec.Body.String())
+	}
+
+	rec = doRequest(router, http.MethodPost, "/MANAGEMENT/2/cancel", nil)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("missing task status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+}
+
+func TestTaskHandler_BulkCreate(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       []map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name: "valid tasks",
+			body: []map[string]interface{}{
+				{"title": "Task A", "due_date": "2026-01-01"},
+				{"title": "Task B", "due_date": "2026-01-02"},
+			},
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name: "one invalid due_date rolls back the batch",
+			body: []map[string]interface{}{
+				{"title": "Task A", "due_date": "2026-01-01"},
+				{"title": "Task B", "due_date": "not-a-date"},
+			},
+			wantStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := newTestRouter(newFakeTaskRepo(), models.User{ID: 1})
+			rec := doRequest(router, http.MethodPost, "/MANAGEMENT/bulk", tt.body)
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body=%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestTaskHandler_BulkDelete(t *testing.T) {
+	repo := newFakeTaskRepo()
+	repo.tasks[1] = models.Task{ID: 1, UserID: 1, Status: "pending"}
+	repo.tasks[2] = models.Task{ID: 2, UserID: 1, Status: "pending"}
+
+	router := newTestRouter(repo, models.User{ID: 1})
+
+	rec := doRequest(router, http.MethodDelete, "/MANAGEMENT/bulk", map[string]interface{}{"ids": []int{1, 2}})
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d (body=%s)", rec.Code, http.StatusOK, rec.Body.String())
+	}
+
+	rec = doRequest(router, http.MethodDelete, "/MANAGEMENT/bulk", map[string]interface{}{"ids": []int{}})
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("empty ids status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestTaskHandler_BulkPatch(t *testing.T) {
+	repo := newFakeTaskRepo()
+	repo.tasks[1] = models.Task{ID: 1, UserID: 1, Status: "pending"}
+
+	router := newTestRouter(repo, models.User{ID: 1})
+
+	rec := doRequest(router, http.MethodPatch, "/MANAGEMENT/bulk", map[string]interface{}{"ids": []int{1}, "fields": map[string]interface{}{"status": "in_progress"}})
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("status = %d, want %d (body=%s)", rec.Code, http.StatusAccepted, rec.Body.String())
+	}
+
+	rec = doRequest(router, http.MethodPatch, "/MANAGEMENT/bulk", map[string]interface{}{"ids": []int{1}, "fields": map[string]interface{}{}})
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("empty fields status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestTaskHandler_Export(t *testing.T) {
+	repo := newFakeTaskRepo()
+	repo.tasks[1] = models.Task{ID: 1, Title: "Task", UserID: 1, Status: "pending", DueDate: "2026-01-01"}
+
+	router := newTestRouter(repo, models.User{ID: 1})
+
+	rec := doRequest(router, http.MethodGet, "/MANAGEMENT/export", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("csv status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	rec = doRequest(router, http.MethodGet, "/MANAGEMENT/export?format=ics", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("ics status = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	rec = doRequest(router, http.MethodGet, "/MANAGEMENT/export?format=xml", nil)
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("unknown format status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestTaskHandler_Import(t *testing.T) {
+	router := newTestRouter(newFakeTaskRepo(), models.User{ID: 1})
+
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	part, err := writer.CreateFormFile("file", "tasks.csv")
+	if err != nil {
+		t.Fatalf("CreateFormFile: %v", err)
+	}
+	if _, err := part.Write([]byte("title,due_date,status\nTask A,2026-01-01,pending\n")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatalf("writer.Close: %v", err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/MANAGEMENT/import", &buf)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("status = %d, want %d (body=%s)", rec.Code, http.StatusCreated, rec.Body.String())
+	}
+}
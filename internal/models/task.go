@@ -0,0 +1,30 @@
+package models
+
+// Task status values. Transitions between them are constrained by
+// service.IsValidStatusTransition: pending -> in_progress -> done.
+const (
+	StatusPending    = "pending"
+	StatusInProgress = "in_progress"
+	StatusDone       = "done"
+)
+
+// Recurrence values supported on a Task.
+const (
+	RecurrenceNone    = "none"
+	RecurrenceDaily   = "daily"
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+)
+
+// Task is a single to-do item owned by a user.
+type Task struct {
+	ID             int    `json:"id"`
+	Title          string `json:"title"`
+	Description    string `json:"description"`
+	DueDate        string `json:"due_date"`
+	Status         string `json:"status"`
+	UserID         int    `json:"user_id"`
+	Recurrence     string `json:"recurrence"`
+	ReminderOffset string `json:"reminder_offset"`
+	NotifiedAt     string `json:"notified_at,omitempty"`
+}
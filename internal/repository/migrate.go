@@ -0,0 +1,37 @@
+package repository
+
+import "database/sql"
+
+// Migrate creates the users and MANAGEMENT tables (and their indexes) if
+// they do not already exist.
+func Migrate(db *sql.DB) error {
+	statements := []string{
+		`CREATE TABLE IF NOT EXISTS users (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			email TEXT NOT NULL UNIQUE,
+			password_hash TEXT NOT NULL,
+			is_admin BOOLEAN NOT NULL DEFAULT 0,
+			created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+		);`,
+		`CREATE TABLE IF NOT EXISTS MANAGEMENT (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			title TEXT NOT NULL,
+			description TEXT,
+			due_date DATE,
+			status TEXT,
+			user_id INTEGER REFERENCES users(id),
+			recurrence TEXT NOT NULL DEFAULT 'none',
+			reminder_offset TEXT NOT NULL DEFAULT '24h',
+			notified_at DATETIME
+		);`,
+		`CREATE INDEX IF NOT EXISTS idx_management_due_date ON MANAGEMENT (due_date);`,
+		`CREATE INDEX IF NOT EXISTS idx_management_status ON MANAGEMENT (status);`,
+	}
+
+	for _, stmt := range statements {
+		if _, err := db.Exec(stmt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,383 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+)
+
+// sortableColumns whitelists the columns allowed in ORDER BY to prevent
+// SQL injection via the caller-supplied sort column.
+var sortableColumns = map[string]bool{
+	"id":       true,
+	"due_date": true,
+	"status":   true,
+	"title":    true,
+}
+
+// TaskFilter narrows and orders the rows returned by TaskRepository.List.
+type TaskFilter struct {
+	UserID    int
+	IsAdmin   bool
+	Page      int
+	PageSize  int
+	SortBy    string
+	SortOrder string
+	Status    string
+	DueBefore string
+	DueAfter  string
+	Q         string
+}
+
+// TaskRepository is the persistence boundary for tasks. Handlers never talk
+// to SQL directly; they go through this interface (and a mock of it in tests).
+type TaskRepository interface {
+	Create(task *models.Task) error
+	GetByID(id int, userID int, isAdmin bool) (*models.Task, error)
+	Update(task *models.Task) error
+	PatchFields(id int, userID int, isAdmin bool, fields map[string]interface{}) error
+	Delete(id int, userID int, isAdmin bool) (bool, error)
+	List(filter TaskFilter) ([]models.Task, int, error)
+	DueForReminder(cutoff string) ([]models.Task, error)
+	MarkNotified(id int, notifiedAt string) error
+	Snooze(id int, userID int, isAdmin bool, newDueDate string) (*models.Task, error)
+	Cancel(id int, userID int, isAdmin bool, notifiedAt string) (bool, error)
+	BulkCreate(tasks []models.Task) error
+	BulkDelete(ids []int, userID int, isAdmin bool) (int64, error)
+	BulkPatch(ids []int, userID int, isAdmin bool, fields map[string]interface{}) (int64, error)
+	ListAll(userID int, isAdmin bool) ([]models.Task, error)
+}
+
+type sqliteTaskRepo struct {
+	db *sql.DB
+}
+
+// NewSQLiteTaskRepository returns a TaskRepository backed by the given
+// *sql.DB (expected to be a sqlite3 connection with MANAGEMENT migrated).
+func NewSQLiteTaskRepository(db *sql.DB) TaskRepository {
+	return &sqliteTaskRepo{db: db}
+}
+
+func (r *sqliteTaskRepo) Create(task *models.Task) error {
+	result, err := r.db.Exec(`
+		INSERT INTO MANAGEMENT (title, description, due_date, status, user_id, recurrence, reminder_offset)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, task.Title, task.Description, task.DueDate, task.Status, task.UserID, task.Recurrence, task.ReminderOffset)
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	task.ID = int(id)
+	return nil
+}
+
+func (r *sqliteTaskRepo) ownerClause(userID int, isAdmin bool) (string, []interface{}) {
+	if isAdmin {
+		return "", nil
+	}
+	return " AND user_id = ?", []interface{}{userID}
+}
+
+func (r *sqliteTaskRepo) GetByID(id int, userID int, isAdmin bool) (*models.Task, error) {
+	clause, clauseArgs := r.ownerClause(userID, isAdmin)
+	args := append([]interface{}{id}, clauseArgs...)
+
+	var task models.Task
+	err := r.db.QueryRow(`
+		SELECT id, title, description, due_date, status, user_id, recurrence, reminder_offset
+		FROM MANAGEMENT WHERE id = ?`+clause, args...,
+	).Scan(&task.ID, &task.Title, &task.Description, &task.DueDate, &task.Status, &task.UserID, &task.Recurrence, &task.ReminderOffset)
+	if err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (r *sqliteTaskRepo) Update(task *models.Task) error {
+	_, err := r.db.Exec(`
+		UPDATE MANAGEMENT
+		SET title = ?, description = ?, due_date = ?, status = ?
+		WHERE id = ?
+	`, task.Title, task.Description, task.DueDate, task.Status, task.ID)
+	return err
+}
+
+func (r *sqliteTaskRepo) PatchFields(id int, userID int, isAdmin bool, fields map[string]interface{}) error {
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	setClauses := make([]string, 0, len(names))
+	args := make([]interface{}, 0, len(names)+2)
+	for _, field := range names {
+		setClauses = append(setClauses, field+" = ?")
+		args = append(args, fields[field])
+	}
+	args = append(args, id)
+
+	clause, clauseArgs := r.ownerClause(userID, isAdmin)
+	args = append(args, clauseArgs...)
+
+	query := fmt.Sprintf("UPDATE MANAGEMENT SET %s WHERE id = ?%s", strings.Join(setClauses, ", "), clause)
+	_, err := r.db.Exec(query, args...)
+	return err
+}
+
+func (r *sqliteTaskRepo) Delete(id int, userID int, isAdmin bool) (bool, error) {
+	clause, clauseArgs := r.ownerClause(userID, isAdmin)
+	args := append([]interface{}{id}, clauseArgs...)
+
+	result, err := r.db.Exec("DELETE FROM MANAGEMENT WHERE id = ?"+clause, args...)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+func (r *sqliteTaskRepo) List(filter TaskFilter) ([]models.Task, int, error) {
+	sortBy := filter.SortBy
+	if !sortableColumns[sortBy] {
+		sortBy = "id"
+	}
+	sortOrder := filter.SortOrder
+	if sortOrder != "ASC" && sortOrder != "DESC" {
+		sortOrder = "ASC"
+	}
+
+	var conditions []string
+	var args []interface{}
+
+	if !filter.IsAdmin {
+		conditions = append(conditions, "user_id = ?")
+		args = append(args, filter.UserID)
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, "status = ?")
+		args = append(args, filter.Status)
+	}
+	if filter.DueBefore != "" {
+		conditions = append(conditions, "due_date <= ?")
+		args = append(args, filter.DueBefore)
+	}
+	if filter.DueAfter != "" {
+		conditions = append(conditions, "due_date >= ?")
+		args = append(args, filter.DueAfter)
+	}
+	if filter.Q != "" {
+		conditions = append(conditions, "(title LIKE ? OR description LIKE ?)")
+		like := "%" + filter.Q + "%"
+		args = append(args, like, like)
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	var total int
+	if err := r.db.QueryRow("SELECT COUNT(*) FROM MANAGEMENT"+whereClause, args...).Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	offset := (filter.Page - 1) * filter.PageSize
+	listQuery := fmt.Sprintf(`
+		SELECT id, title, description, due_date, status, user_id, recurrence, reminder_offset
+		FROM MANAGEMENT%s
+		ORDER BY %s %s
+		LIMIT ? OFFSET ?
+	`, whereClause, sortBy, sortOrder)
+
+	rows, err := r.db.Query(listQuery, append(args, filter.PageSize, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	tasks := []models.Task{}
+	for rows.Next() {
+		var task models.Task
+		if err := rows.Scan(&task.ID, &task.Title, &task.Description, &task.DueDate, &task.Status, &task.UserID, &task.Recurrence, &task.ReminderOffset); err != nil {
+			return nil, 0, err
+		}
+		tasks = append(tasks, task)
+	}
+
+	return tasks, total, nil
+}
+
+func (r *sqliteTaskRepo) DueForReminder(cutoff string) ([]models.Task, error) {
+	rows, err := r.db.Query(`
+		SELECT id, title, description, due_date, status, user_id, recurrence, reminder_offset
+		FROM MANAGEMENT
+		WHERE due_date <= ? AND status != ? AND notified_at IS NULL
+	`, cutoff, models.StatusDone)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []models.Task
+	for rows.Next() {
+		var task models.Task
+		if err := rows.Scan(&task.ID, &task.Title, &task.Description, &task.DueDate, &task.Status, &task.UserID, &task.Recurrence, &task.ReminderOffset); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func (r *sqliteTaskRepo) MarkNotified(id int, notifiedAt string) error {
+	_, err := r.db.Exec(`UPDATE MANAGEMENT SET notified_at = ? WHERE id = ?`, notifiedAt, id)
+	return err
+}
+
+func (r *sqliteTaskRepo) Snooze(id int, userID int, isAdmin bool, newDueDate string) (*models.Task, error) {
+	clause, clauseArgs := r.ownerClause(userID, isAdmin)
+	args := append([]interface{}{newDueDate, id}, clauseArgs...)
+
+	result, err := r.db.Exec("UPDATE MANAGEMENT SET due_date = ?, notified_at = NULL WHERE id = ?"+clause, args...)
+	if err != nil {
+		return nil, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rowsAffected == 0 {
+		return nil, sql.ErrNoRows
+	}
+	return r.GetByID(id, userID, isAdmin)
+}
+
+func (r *sqliteTaskRepo) Cancel(id int, userID int, isAdmin bool, notifiedAt string) (bool, error) {
+	clause, clauseArgs := r.ownerClause(userID, isAdmin)
+	args := append([]interface{}{models.RecurrenceNone, notifiedAt, id}, clauseArgs...)
+
+	result, err := r.db.Exec("UPDATE MANAGEMENT SET recurrence = ?, notified_at = ? WHERE id = ?"+clause, args...)
+	if err != nil {
+		return false, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rowsAffected > 0, nil
+}
+
+// BulkCreate inserts every task in a single transaction, rolling back (and
+// leaving no task created) if any insert fails.
+func (r *sqliteTaskRepo) BulkCreate(tasks []models.Task) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	for i := range tasks {
+		task := &tasks[i]
+		result, err := tx.Exec(`
+			INSERT INTO MANAGEMENT (title, description, due_date, status, user_id, recurrence, reminder_offset)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+		`, task.Title, task.Description, task.DueDate, task.Status, task.UserID, task.Recurrence, task.ReminderOffset)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		id, err := result.LastInsertId()
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+		task.ID = int(id)
+	}
+
+	return tx.Commit()
+}
+
+func idPlaceholders(ids []int) (string, []interface{}) {
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+	return strings.Join(placeholders, ", "), args
+}
+
+func (r *sqliteTaskRepo) BulkDelete(ids []int, userID int, isAdmin bool) (int64, error) {
+	placeholders, args := idPlaceholders(ids)
+	clause, clauseArgs := r.ownerClause(userID, isAdmin)
+	args = append(args, clauseArgs...)
+
+	query := fmt.Sprintf("DELETE FROM MANAGEMENT WHERE id IN (%s)%s", placeholders, clause)
+	result, err := r.db.Exec(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (r *sqliteTaskRepo) BulkPatch(ids []int, userID int, isAdmin bool, fields map[string]interface{}) (int64, error) {
+	names := make([]string, 0, len(fields))
+	for field := range fields {
+		names = append(names, field)
+	}
+	sort.Strings(names)
+
+	setClauses := make([]string, 0, len(names))
+	args := make([]interface{}, 0, len(names)+len(ids)+1)
+	for _, field := range names {
+		setClauses = append(setClauses, field+" = ?")
+		args = append(args, fields[field])
+	}
+
+	placeholders, idArgs := idPlaceholders(ids)
+	args = append(args, idArgs...)
+
+	clause, clauseArgs := r.ownerClause(userID, isAdmin)
+	args = append(args, clauseArgs...)
+
+	query := fmt.Sprintf("UPDATE MANAGEMENT SET %s WHERE id IN (%s)%s", strings.Join(setClauses, ", "), placeholders, clause)
+	result, err := r.db.Exec(query, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// ListAll returns every task the caller may see, unpaginated, for export.
+func (r *sqliteTaskRepo) ListAll(userID int, isAdmin bool) ([]models.Task, error) {
+	clause, clauseArgs := r.ownerClause(userID, isAdmin)
+	query := "SELECT id, title, description, due_date, status, user_id, recurrence, reminder_offset FROM MANAGEMENT"
+	if clause != "" {
+		query += " WHERE" + strings.TrimPrefix(clause, " AND")
+	}
+
+	rows, err := r.db.Query(query, clauseArgs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tasks := []models.Task{}
+	for rows.Next() {
+		var task models.Task
+		if err := rows.Scan(&task.ID, &task.Title, &task.Description, &task.DueDate, &task.Status, &task.UserID, &task.Recurrence, &task.ReminderOffset); err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
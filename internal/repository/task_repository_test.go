@@ -0,0 +1,359 @@
+package repository
+
+import (
+	"errors"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+)
+
+var errTaskRepoTest = errors.New("db unavailable")
+
+func TestSqliteTaskRepo_Create(t *testing.T) {
+	tests := []struct {
+		name    string
+		mock    func(sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "success",
+			mock: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("INSERT INTO MANAGEMENT").
+					WithArgs("Write report", "", "2026-01-01", "pending", 1, "none", "24h").
+					WillReturnResult(sqlmock.NewResult(7, 1))
+			},
+			wantErr: false,
+		},
+		{
+			name: "db error",
+			mock: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("INSERT INTO MANAGEMENT").
+					WillReturnError(errTaskRepoTest)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New: %v", err)
+			}
+			defer db.Close()
+			tt.mock(mock)
+
+			repo := NewSQLiteTaskRepository(db)
+			task := &models.Task{
+				Title:          "Write report",
+				DueDate:        "2026-01-01",
+				Status:         "pending",
+				UserID:         1,
+				Recurrence:     "none",
+				ReminderOffset: "24h",
+			}
+			got := repo.Create(task)
+
+			if (got != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", got, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestSqliteTaskRepo_List(t *testing.T) {
+	columns := []string{"id", "title", "description", "due_date", "status", "user_id", "recurrence", "reminder_offset"}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM MANAGEMENT WHERE user_id = \?`).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`SELECT id, title, description, due_date, status, user_id, recurrence, reminder_offset\s+FROM MANAGEMENT WHERE user_id = \?\s+ORDER BY id ASC\s+LIMIT \? OFFSET \?`).
+		WithArgs(1, 10, 0).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(7, "Write report", "", "2026-01-01", "pending", 1, "none", "24h"))
+
+	repo := NewSQLiteTaskRepository(db)
+	tasks, total, err := repo.List(TaskFilter{UserID: 1, Page: 1, PageSize: 10, SortBy: "id", SortOrder: "ASC"})
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if total != 1 || len(tasks) != 1 {
+		t.Fatalf("List() = %d tasks, total %d; want 1, 1", len(tasks), total)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestSqliteTaskRepo_PatchFields(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE MANAGEMENT SET status = \? WHERE id = \? AND user_id = \?`).
+		WithArgs("done", 7, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewSQLiteTaskRepository(db)
+	if err := repo.PatchFields(7, 1, false, map[string]interface{}{"status": "done"}); err != nil {
+		t.Fatalf("PatchFields() error = %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestSqliteTaskRepo_Snooze(t *testing.T) {
+	columns := []string{"id", "title", "description", "due_date", "status", "user_id", "recurrence", "reminder_offset"}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE MANAGEMENT SET due_date = \?, notified_at = NULL WHERE id = \? AND user_id = \?`).
+		WithArgs("2026-01-02", 7, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT id, title, description, due_date, status, user_id, recurrence, reminder_offset\s+FROM MANAGEMENT WHERE id = \? AND user_id = \?`).
+		WithArgs(7, 1).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(7, "Write report", "", "2026-01-02", "pending", 1, "none", "24h"))
+
+	repo := NewSQLiteTaskRepository(db)
+	task, err := repo.Snooze(7, 1, false, "2026-01-02")
+	if err != nil {
+		t.Fatalf("Snooze() error = %v", err)
+	}
+	if task.DueDate != "2026-01-02" {
+		t.Errorf("Snooze() due_date = %q, want %q", task.DueDate, "2026-01-02")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestSqliteTaskRepo_Cancel(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE MANAGEMENT SET recurrence = \?, notified_at = \? WHERE id = \? AND user_id = \?`).
+		WithArgs(models.RecurrenceNone, "2026-01-01T00:00:00Z", 7, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewSQLiteTaskRepository(db)
+	cancelled, err := repo.Cancel(7, 1, false, "2026-01-01T00:00:00Z")
+	if err != nil {
+		t.Fatalf("Cancel() error = %v", err)
+	}
+	if !cancelled {
+		t.Errorf("Cancel() cancelled = false, want true")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestSqliteTaskRepo_BulkCreate(t *testing.T) {
+	tests := []struct {
+		name    string
+		mock    func(sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "success commits",
+			mock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec("INSERT INTO MANAGEMENT").
+					WithArgs("Write report", "", "2026-01-01", "pending", 1, "none", "24h").
+					WillReturnResult(sqlmock.NewResult(7, 1))
+				m.ExpectCommit()
+			},
+		},
+		{
+			name: "row error rolls back",
+			mock: func(m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectExec("INSERT INTO MANAGEMENT").
+					WillReturnError(errTaskRepoTest)
+				m.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New: %v", err)
+			}
+			defer db.Close()
+			tt.mock(mock)
+
+			repo := NewSQLiteTaskRepository(db)
+			tasks := []models.Task{{Title: "Write report", DueDate: "2026-01-01", Status: "pending", UserID: 1, Recurrence: "none", ReminderOffset: "24h"}}
+			got := repo.BulkCreate(tasks)
+
+			if (got != nil) != tt.wantErr {
+				t.Fatalf("BulkCreate() error = %v, wantErr %v", got, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestSqliteTaskRepo_BulkDelete(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(`DELETE FROM MANAGEMENT WHERE id IN \(\?, \?\) AND user_id = \?`).
+		WithArgs(7, 8, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := NewSQLiteTaskRepository(db)
+	deleted, err := repo.BulkDelete([]int{7, 8}, 1, false)
+	if err != nil {
+		t.Fatalf("BulkDelete() error = %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("BulkDelete() deleted = %d, want 2", deleted)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestSqliteTaskRepo_BulkPatch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE MANAGEMENT SET status = \? WHERE id IN \(\?, \?\) AND user_id = \?`).
+		WithArgs("done", 7, 8, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := NewSQLiteTaskRepository(db)
+	updated, err := repo.BulkPatch([]int{7, 8}, 1, false, map[string]interface{}{"status": "done"})
+	if err != nil {
+		t.Fatalf("BulkPatch() error = %v", err)
+	}
+	if updated != 2 {
+		t.Errorf("BulkPatch() updated = %d, want 2", updated)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestSqliteTaskRepo_DueForReminder(t *testing.T) {
+	columns := []string{"id", "title", "description", "due_date", "status", "user_id", "recurrence", "reminder_offset"}
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("sqlmock.New: %v", err)
+	}
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT id, title, description, due_date, status, user_id, recurrence, reminder_offset\s+FROM MANAGEMENT\s+WHERE due_date <= \? AND status != \? AND notified_at IS NULL`).
+		WithArgs("2026-02-01", models.StatusDone).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(7, "Write report", "", "2026-01-01", "pending", 1, "none", "24h"))
+
+	repo := NewSQLiteTaskRepository(db)
+	tasks, err := repo.DueForReminder("2026-02-01")
+	if err != nil {
+		t.Fatalf("DueForReminder() error = %v", err)
+	}
+	if len(tasks) != 1 {
+		t.Fatalf("DueForReminder() = %d tasks, want 1", len(tasks))
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("unmet expectations: %v", err)
+	}
+}
+
+func TestSqliteTaskRepo_Delete(t *testing.T) {
+	tests := []struct {
+		name        string
+		isAdmin     bool
+		mock        func(sqlmock.Sqlmock)
+		wantDeleted bool
+		wantErr     bool
+	}{
+		{
+			name:    "owner deletes own task",
+			isAdmin: false,
+			mock: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("DELETE FROM MANAGEMENT WHERE id = \\? AND user_id = \\?").
+					WithArgs(7, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantDeleted: true,
+		},
+		{
+			name:    "no matching row",
+			isAdmin: false,
+			mock: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("DELETE FROM MANAGEMENT WHERE id = \\? AND user_id = \\?").
+					WithArgs(7, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			wantDeleted: false,
+		},
+		{
+			name:    "admin bypasses ownership",
+			isAdmin: true,
+			mock: func(m sqlmock.Sqlmock) {
+				m.ExpectExec("DELETE FROM MANAGEMENT WHERE id = \\?$").
+					WithArgs(7).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			wantDeleted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("sqlmock.New: %v", err)
+			}
+			defer db.Close()
+			tt.mock(mock)
+
+			repo := NewSQLiteTaskRepository(db)
+			deleted, err := repo.Delete(7, 1, tt.isAdmin)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if deleted != tt.wantDeleted {
+				t.Errorf("Delete() deleted = %v, want %v", deleted, tt.wantDeleted)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+)
+
+// UserRepository is the persistence boundary for user accounts.
+type UserRepository interface {
+	Create(email, passwordHash string) (int, error)
+	GetByEmail(email string) (*models.User, error)
+}
+
+type sqliteUserRepo struct {
+	db *sql.DB
+}
+
+// NewSQLiteUserRepository returns a UserRepository backed by the given
+// *sql.DB (expected to be a sqlite3 connection with users migrated).
+func NewSQLiteUserRepository(db *sql.DB) UserRepository {
+	return &sqliteUserRepo{db: db}
+}
+
+func (r *sqliteUserRepo) Create(email, passwordHash string) (int, error) {
+	result, err := r.db.Exec(`INSERT INTO users (email, password_hash) VALUES (?, ?)`, email, passwordHash)
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+func (r *sqliteUserRepo) GetByEmail(email string) (*models.User, error) {
+	var user models.User
+	err := r.db.QueryRow(`
+		SELECT id, email, password_hash, is_admin FROM users WHERE email = ?
+	`, email).Scan(&user.ID, &user.Email, &user.PasswordHash, &user.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
@@ -0,0 +1,75 @@
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"os"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+)
+
+// Notifier delivers a due-date reminder for a task. Implementations are
+// pluggable so the scheduler can be wired to email, a webhook, or a
+// no-op stub in tests.
+type Notifier interface {
+	Notify(task models.Task) error
+}
+
+// NoopNotifier discards reminders; NewNotifierFromEnv returns it when
+// NOTIFIER is unset or unrecognised.
+type NoopNotifier struct{}
+
+func (NoopNotifier) Notify(task models.Task) error { return nil }
+
+// EmailNotifier sends reminders over SMTP.
+type EmailNotifier struct {
+	SMTPAddr string
+	From     string
+	To       string
+}
+
+func (e EmailNotifier) Notify(task models.Task) error {
+	msg := []byte(fmt.Sprintf("Subject: Task reminder: %s\r\n\r\n%q is due on %s\r\n", task.Title, task.Title, task.DueDate))
+	return smtp.SendMail(e.SMTPAddr, nil, e.From, []string{e.To}, msg)
+}
+
+// WebhookNotifier POSTs the task as JSON to a configured URL.
+type WebhookNotifier struct {
+	URL string
+}
+
+func (w WebhookNotifier) Notify(task models.Task) error {
+	payload, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	resp, err := http.Post(w.URL, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// NewNotifierFromEnv builds the Notifier the scheduler uses, selected via
+// the NOTIFIER environment variable (email, webhook, or noop/unset).
+func NewNotifierFromEnv() Notifier {
+	switch os.Getenv("NOTIFIER") {
+	case "email":
+		return EmailNotifier{
+			SMTPAddr: os.Getenv("SMTP_ADDR"),
+			From:     os.Getenv("SMTP_FROM"),
+			To:       os.Getenv("SMTP_TO"),
+		}
+	case "webhook":
+		return WebhookNotifier{URL: os.Getenv("WEBHOOK_URL")}
+	default:
+		return NoopNotifier{}
+	}
+}
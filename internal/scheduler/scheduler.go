@@ -0,0 +1,92 @@
+package scheduler
+
+import (
+	"log"
+	"os"
+	"time"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/repository"
+)
+
+// defaultWindow returns how far ahead of due_date the scheduler starts
+// notifying, configurable via REMINDER_WINDOW (a Go duration string).
+func defaultWindow() time.Duration {
+	if v := os.Getenv("REMINDER_WINDOW"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return 24 * time.Hour
+}
+
+// maxLookahead bounds how far ahead of now the scheduler asks the repository
+// for candidate tasks, so the notified_at scan doesn't have to touch every
+// row in the table. It must be generous enough to cover any realistic
+// reminder_offset; the actual notify decision is made per-task below.
+const maxLookahead = 30 * 24 * time.Hour
+
+// Scheduler scans MANAGEMENT every minute for tasks due within their own
+// reminder_offset and notifies on them exactly once, tracked via the
+// notified_at column so restarts don't double-notify.
+type Scheduler struct {
+	repo     repository.TaskRepository
+	notifier Notifier
+	window   time.Duration
+}
+
+// New builds a Scheduler with the default reminder window.
+func New(repo repository.TaskRepository, notifier Notifier) *Scheduler {
+	return &Scheduler{repo: repo, notifier: notifier, window: defaultWindow()}
+}
+
+// Start launches the scan loop in a background goroutine.
+func (s *Scheduler) Start() {
+	ticker := time.NewTicker(time.Minute)
+	go func() {
+		for range ticker.C {
+			s.scan()
+		}
+	}()
+}
+
+func (s *Scheduler) scan() {
+	now := time.Now()
+	cutoff := now.Add(maxLookahead).Format("2006-01-02")
+
+	tasks, err := s.repo.DueForReminder(cutoff)
+	if err != nil {
+		log.Println("Error scanning due tasks:", err)
+		return
+	}
+
+	for _, task := range tasks {
+		if !s.isDue(task, now) {
+			continue
+		}
+		if err := s.notifier.Notify(task); err != nil {
+			log.Println("Error notifying for task", task.ID, ":", err)
+			continue
+		}
+		if err := s.repo.MarkNotified(task.ID, now.Format(time.RFC3339)); err != nil {
+			log.Println("Error marking task notified:", err)
+		}
+	}
+}
+
+// isDue reports whether task should be notified now, based on its own
+// reminder_offset (how long before due_date to start reminding). Falls back
+// to the scheduler's global window if the task has no valid offset.
+func (s *Scheduler) isDue(task models.Task, now time.Time) bool {
+	offset, err := time.ParseDuration(task.ReminderOffset)
+	if err != nil {
+		offset = s.window
+	}
+
+	dueDate, err := time.Parse("2006-01-02", task.DueDate)
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(dueDate.Add(-offset))
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/repository"
+)
+
+// AuthClaims is the JWT payload issued on login and verified on every
+// authenticated request.
+type AuthClaims struct {
+	UserID  int  `json:"user_id"`
+	IsAdmin bool `json:"is_admin"`
+	jwt.RegisteredClaims
+}
+
+// AuthService handles registration and login: password hashing and JWT
+// issuance/verification.
+type AuthService struct {
+	users      repository.UserRepository
+	signingKey []byte
+}
+
+// NewAuthService builds an AuthService over the given user repository,
+// signing tokens with signingKey.
+func NewAuthService(users repository.UserRepository, signingKey []byte) *AuthService {
+	return &AuthService{users: users, signingKey: signingKey}
+}
+
+func (s *AuthService) Register(email, password string) (*models.User, error) {
+	if email == "" || password == "" {
+		return nil, fmt.Errorf("%w: email and password are required", ErrValidation)
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.users.Create(email, string(hash))
+	if err != nil {
+		return nil, ErrEmailTaken
+	}
+
+	return &models.User{ID: id, Email: email}, nil
+}
+
+func (s *AuthService) Login(email, password string) (string, error) {
+	user, err := s.users.GetByEmail(email)
+	if err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return "", ErrInvalidCredentials
+	}
+
+	claims := AuthClaims{
+		UserID:  user.ID,
+		IsAdmin: user.IsAdmin,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(24 * time.Hour)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(s.signingKey)
+}
+
+// ParseToken verifies a bearer token and returns its claims.
+func (s *AuthService) ParseToken(tokenString string) (*AuthClaims, error) {
+	claims := &AuthClaims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		return s.signingKey, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidCredentials
+	}
+	return claims, nil
+}
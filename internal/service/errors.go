@@ -0,0 +1,12 @@
+package service
+
+import "errors"
+
+// Sentinel errors handlers map to HTTP status codes.
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrValidation         = errors.New("validation failed")
+	ErrInvalidTransition  = errors.New("invalid status transition")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrEmailTaken         = errors.New("email already registered")
+)
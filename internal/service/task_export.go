@@ -0,0 +1,161 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+)
+
+var csvHeader = []string{"id", "title", "description", "due_date", "status", "recurrence", "reminder_offset"}
+
+// icsStatus maps a Task's status to the iCalendar VTODO STATUS value.
+var icsStatus = map[string]string{
+	models.StatusPending:    "NEEDS-ACTION",
+	models.StatusInProgress: "IN-PROCESS",
+	models.StatusDone:       "COMPLETED",
+}
+
+var icsStatusReverse = map[string]string{
+	"NEEDS-ACTION": models.StatusPending,
+	"IN-PROCESS":   models.StatusInProgress,
+	"COMPLETED":    models.StatusDone,
+}
+
+// WriteCSV streams tasks as CSV to w: id,title,description,due_date,status,recurrence,reminder_offset.
+func WriteCSV(w io.Writer, tasks []models.Task) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, task := range tasks {
+		row := []string{
+			strconv.Itoa(task.ID),
+			task.Title,
+			task.Description,
+			task.DueDate,
+			task.Status,
+			task.Recurrence,
+			task.ReminderOffset,
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteICS streams tasks as an iCalendar feed with one VTODO per task, so
+// users can subscribe to their task list in calendar clients.
+func WriteICS(w io.Writer, tasks []models.Task) error {
+	lines := []string{"BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//TASK-MANAGEMENT-API//EN"}
+	for _, task := range tasks {
+		status, ok := icsStatus[task.Status]
+		if !ok {
+			status = "NEEDS-ACTION"
+		}
+		lines = append(lines,
+			"BEGIN:VTODO",
+			fmt.Sprintf("UID:%d", task.ID),
+			fmt.Sprintf("SUMMARY:%s", icsEscape(task.Title)),
+			fmt.Sprintf("DUE;VALUE=DATE:%s", strings.ReplaceAll(task.DueDate, "-", "")),
+			fmt.Sprintf("STATUS:%s", status),
+			"END:VTODO",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	_, err := io.WriteString(w, strings.Join(lines, "\r\n")+"\r\n")
+	return err
+}
+
+func icsEscape(s string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `,`, `\,`, `;`, `\;`)
+	return replacer.Replace(s)
+}
+
+// ParseCSV reads tasks from a CSV file with the same columns WriteCSV
+// produces (id and status columns are optional and default sensibly).
+func ParseCSV(r io.Reader) ([]models.Task, error) {
+	reader := csv.NewReader(r)
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	header := rows[0]
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.TrimSpace(strings.ToLower(name))] = i
+	}
+
+	field := func(row []string, name string) string {
+		i, ok := index[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return row[i]
+	}
+
+	var tasks []models.Task
+	for _, row := range rows[1:] {
+		task := models.Task{
+			Title:          field(row, "title"),
+			Description:    field(row, "description"),
+			DueDate:        field(row, "due_date"),
+			Status:         field(row, "status"),
+			Recurrence:     field(row, "recurrence"),
+			ReminderOffset: field(row, "reminder_offset"),
+		}
+		if task.Status == "" {
+			task.Status = models.StatusPending
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+// ParseICS reads tasks from an iCalendar feed, one per VTODO block.
+func ParseICS(r io.Reader) ([]models.Task, error) {
+	var tasks []models.Task
+	var current *models.Task
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		switch {
+		case line == "BEGIN:VTODO":
+			current = &models.Task{Status: models.StatusPending, Recurrence: models.RecurrenceNone, ReminderOffset: "24h"}
+		case line == "END:VTODO":
+			if current != nil {
+				tasks = append(tasks, *current)
+				current = nil
+			}
+		case current != nil && strings.HasPrefix(line, "SUMMARY:"):
+			current.Title = strings.TrimPrefix(line, "SUMMARY:")
+		case current != nil && strings.HasPrefix(line, "DUE"):
+			due := line[strings.Index(line, ":")+1:]
+			if len(due) == 8 {
+				due = due[0:4] + "-" + due[4:6] + "-" + due[6:8]
+			}
+			current.DueDate = due
+		case current != nil && strings.HasPrefix(line, "STATUS:"):
+			value := strings.TrimPrefix(line, "STATUS:")
+			if mapped, ok := icsStatusReverse[value]; ok {
+				current.Status = mapped
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
@@ -0,0 +1,392 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/models"
+	"github.com/Ankur9350/TASK-MANAGEMENT-API/internal/repository"
+)
+
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 100
+
+	dateLayout = "2006-01-02"
+)
+
+// patchableFields whitelists the columns a partial update (PATCH) may modify.
+var patchableFields = map[string]bool{
+	"title":       true,
+	"description": true,
+	"due_date":    true,
+	"status":      true,
+}
+
+// validStatusTransitions enforces the only allowed status progression:
+// pending -> in_progress -> done. Any other jump is rejected.
+var validStatusTransitions = map[string][]string{
+	models.StatusPending:    {models.StatusInProgress},
+	models.StatusInProgress: {models.StatusDone},
+	models.StatusDone:       {},
+}
+
+// IsValidStatusTransition reports whether a task may move from `from` to
+// `to`. Staying on the same status is always allowed (a no-op update), and an
+// empty `from` (a task predating the pending default) is treated as pending.
+func IsValidStatusTransition(from, to string) bool {
+	if from == "" {
+		from = models.StatusPending
+	}
+	if from == to {
+		return true
+	}
+	for _, allowed := range validStatusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
+// TaskService holds the business rules around tasks: due-date validation,
+// status-transition enforcement, ownership scoping, and recurrence.
+type TaskService struct {
+	repo repository.TaskRepository
+}
+
+// NewTaskService builds a TaskService over the given repository.
+func NewTaskService(repo repository.TaskRepository) *TaskService {
+	return &TaskService{repo: repo}
+}
+
+func (s *TaskService) Create(task models.Task) (*models.Task, error) {
+	dueDate, err := time.Parse(dateLayout, task.DueDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid due_date format", ErrValidation)
+	}
+	task.DueDate = dueDate.Format(dateLayout)
+
+	if task.Status == "" {
+		task.Status = models.StatusPending
+	}
+	if task.Recurrence == "" {
+		task.Recurrence = models.RecurrenceNone
+	}
+	if task.ReminderOffset == "" {
+		task.ReminderOffset = "24h"
+	}
+
+	if err := s.repo.Create(&task); err != nil {
+		return nil, err
+	}
+	return &task, nil
+}
+
+func (s *TaskService) Get(id, userID int, isAdmin bool) (*models.Task, error) {
+	task, err := s.repo.GetByID(id, userID, isAdmin)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+	return task, nil
+}
+
+// Replace overwrites a task's title, description, due_date and status (PUT
+// semantics), validating the status transition against the task's current
+// status. recurrence and reminder_offset are not changed.
+func (s *TaskService) Replace(id, userID int, isAdmin bool, updated models.Task) (*models.Task, error) {
+	existing, err := s.repo.GetByID(id, userID, isAdmin)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	if !IsValidStatusTransition(existing.Status, updated.Status) {
+		return nil, fmt.Errorf("%w: cannot move from %q to %q", ErrInvalidTransition, existing.Status, updated.Status)
+	}
+
+	updated.ID = existing.ID
+	if err := s.repo.Update(&updated); err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.GetByID(id, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status == models.StatusDone && existing.Status != models.StatusDone {
+		s.scheduleNextOccurrence(*result)
+	}
+	return result, nil
+}
+
+// Patch applies a partial update (PATCH semantics): only whitelisted fields
+// present in `fields` are changed, and a status change is transition-checked.
+func (s *TaskService) Patch(id, userID int, isAdmin bool, fields map[string]interface{}) (*models.Task, error) {
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%w: no fields provided", ErrValidation)
+	}
+	for field := range fields {
+		if !patchableFields[field] {
+			return nil, fmt.Errorf("%w: field %q is not updatable", ErrValidation, field)
+		}
+	}
+
+	existing, err := s.repo.GetByID(id, userID, isAdmin)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	if rawStatus, ok := fields["status"]; ok {
+		newStatus, ok := rawStatus.(string)
+		if !ok {
+			return nil, fmt.Errorf("%w: status must be a string", ErrValidation)
+		}
+		if !IsValidStatusTransition(existing.Status, newStatus) {
+			return nil, fmt.Errorf("%w: cannot move from %q to %q", ErrInvalidTransition, existing.Status, newStatus)
+		}
+	}
+
+	if err := s.repo.PatchFields(id, userID, isAdmin, fields); err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.GetByID(id, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Status == models.StatusDone && existing.Status != models.StatusDone {
+		s.scheduleNextOccurrence(*result)
+	}
+	return result, nil
+}
+
+func (s *TaskService) Delete(id, userID int, isAdmin bool) error {
+	deleted, err := s.repo.Delete(id, userID, isAdmin)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// List resolves pagination/sort/filter defaults on filter in place (so the
+// caller can see the resolved page/page_size) and delegates to the
+// repository, returning the page of tasks alongside the total row count.
+func (s *TaskService) List(filter *repository.TaskFilter) ([]models.Task, int, error) {
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize < 1 {
+		filter.PageSize = DefaultPageSize
+	}
+	if filter.PageSize > MaxPageSize {
+		filter.PageSize = MaxPageSize
+	}
+	if filter.SortBy == "" {
+		filter.SortBy = "id"
+	}
+	if filter.SortOrder == "" {
+		filter.SortOrder = "ASC"
+	}
+	return s.repo.List(*filter)
+}
+
+// Snooze pushes a task's due_date forward by offset and clears notified_at
+// so the scheduler reminds again closer to the new date. due_date is a plain
+// date, so offset is added to its midnight and the result is truncated to a
+// day: an offset shorter than 24h leaves due_date unchanged.
+func (s *TaskService) Snooze(id, userID int, isAdmin bool, offset time.Duration) (*models.Task, error) {
+	existing, err := s.repo.GetByID(id, userID, isAdmin)
+	if err != nil {
+		return nil, ErrNotFound
+	}
+
+	dueDate, err := time.Parse(dateLayout, existing.DueDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w: task has an invalid due_date", ErrValidation)
+	}
+	newDueDate := dueDate.Add(offset).Format(dateLayout)
+
+	return s.repo.Snooze(id, userID, isAdmin, newDueDate)
+}
+
+// Cancel stops future reminders and recurrence for a task without touching
+// its status, so the status-transition history stays intact.
+func (s *TaskService) Cancel(id, userID int, isAdmin bool) error {
+	cancelled, err := s.repo.Cancel(id, userID, isAdmin, time.Now().Format(time.RFC3339))
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// BulkResult reports the outcome of one row of a bulk operation.
+type BulkResult struct {
+	Index int    `json:"index"`
+	ID    int    `json:"id,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// BulkCreate validates every task up front; if any row fails validation the
+// whole batch is rejected (nothing is inserted) and the per-row results
+// report which rows were invalid and which were skipped. Otherwise all rows
+// are inserted in a single transaction.
+func (s *TaskService) BulkCreate(tasks []models.Task, userID int) ([]BulkResult, error) {
+	results := make([]BulkResult, len(tasks))
+	hasInvalid := false
+
+	for i := range tasks {
+		tasks[i].UserID = userID
+		dueDate, err := time.Parse(dateLayout, tasks[i].DueDate)
+		if err != nil {
+			results[i] = BulkResult{Index: i, Error: "invalid due_date format"}
+			hasInvalid = true
+			continue
+		}
+		tasks[i].DueDate = dueDate.Format(dateLayout)
+		if tasks[i].Status == "" {
+			tasks[i].Status = models.StatusPending
+		}
+		if tasks[i].Recurrence == "" {
+			tasks[i].Recurrence = models.RecurrenceNone
+		}
+		if tasks[i].ReminderOffset == "" {
+			tasks[i].ReminderOffset = "24h"
+		}
+	}
+
+	if hasInvalid {
+		for i := range results {
+			if results[i].Error == "" {
+				results[i] = BulkResult{Index: i, Error: "skipped: batch rolled back due to another row's validation failure"}
+			}
+		}
+		return results, nil
+	}
+
+	if err := s.repo.BulkCreate(tasks); err != nil {
+		for i := range results {
+			results[i] = BulkResult{Index: i, Error: "failed to create task"}
+		}
+		return results, err
+	}
+
+	for i := range tasks {
+		results[i] = BulkResult{Index: i, ID: tasks[i].ID}
+	}
+	return results, nil
+}
+
+func (s *TaskService) BulkDelete(ids []int, userID int, isAdmin bool) (int64, error) {
+	return s.repo.BulkDelete(ids, userID, isAdmin)
+}
+
+func (s *TaskService) BulkPatch(ids []int, userID int, isAdmin bool, fields map[string]interface{}) (int64, error) {
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("%w: no fields provided", ErrValidation)
+	}
+	for field := range fields {
+		if !patchableFields[field] {
+			return 0, fmt.Errorf("%w: field %q is not updatable", ErrValidation, field)
+		}
+	}
+	if rawStatus, ok := fields["status"]; ok {
+		newStatus, ok := rawStatus.(string)
+		if !ok {
+			return 0, fmt.Errorf("%w: status must be a string", ErrValidation)
+		}
+		for _, id := range ids {
+			existing, err := s.repo.GetByID(id, userID, isAdmin)
+			if err != nil {
+				continue
+			}
+			if !IsValidStatusTransition(existing.Status, newStatus) {
+				return 0, fmt.Errorf("%w: task %d cannot move from %q to %q", ErrInvalidTransition, id, existing.Status, newStatus)
+			}
+		}
+	}
+	return s.repo.BulkPatch(ids, userID, isAdmin, fields)
+}
+
+// Export returns every task visible to the caller, for CSV/ICS download.
+func (s *TaskService) Export(userID int, isAdmin bool) ([]models.Task, error) {
+	return s.repo.ListAll(userID, isAdmin)
+}
+
+// ImportResult tallies the outcome of a CSV/ICS import.
+type ImportResult struct {
+	Created int `json:"created"`
+	Skipped int `json:"skipped"`
+	Failed  int `json:"failed"`
+}
+
+// Import creates each parsed task independently, tallying how many were
+// created, skipped (missing a title), or failed (e.g. bad due_date).
+func (s *TaskService) Import(tasks []models.Task, userID int) ImportResult {
+	var result ImportResult
+	for _, task := range tasks {
+		if task.Title == "" {
+			result.Skipped++
+			continue
+		}
+		task.UserID = userID
+		if _, err := s.Create(task); err != nil {
+			result.Failed++
+			continue
+		}
+		result.Created++
+	}
+	return result
+}
+
+// nextOccurrenceDueDate advances due by one recurrence interval.
+func nextOccurrenceDueDate(due, recurrence string) (string, error) {
+	t, err := time.Parse(dateLayout, due)
+	if err != nil {
+		return "", err
+	}
+	switch recurrence {
+	case models.RecurrenceDaily:
+		t = t.AddDate(0, 0, 1)
+	case models.RecurrenceWeekly:
+		t = t.AddDate(0, 0, 7)
+	case models.RecurrenceMonthly:
+		t = t.AddDate(0, 1, 0)
+	default:
+		return "", fmt.Errorf("task is not recurring")
+	}
+	return t.Format(dateLayout), nil
+}
+
+// scheduleNextOccurrence inserts the next instance of a recurring task once
+// the current one is marked done. Non-recurring tasks are left untouched.
+func (s *TaskService) scheduleNextOccurrence(task models.Task) {
+	if task.Recurrence == "" || task.Recurrence == models.RecurrenceNone {
+		return
+	}
+	nextDue, err := nextOccurrenceDueDate(task.DueDate, task.Recurrence)
+	if err != nil {
+		log.Println("Error computing next occurrence for task", task.ID, ":", err)
+		return
+	}
+	next := models.Task{
+		Title:          task.Title,
+		Description:    task.Description,
+		DueDate:        nextDue,
+		Status:         models.StatusPending,
+		UserID:         task.UserID,
+		Recurrence:     task.Recurrence,
+		ReminderOffset: task.ReminderOffset,
+	}
+	if err := s.repo.Create(&next); err != nil {
+		log.Println("Error inserting next occurrence for task", task.ID, ":", err)
+	}
+}